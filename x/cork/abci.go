@@ -0,0 +1,32 @@
+package cork
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/peggyjv/sommelier/v4/x/cork/keeper"
+	"github.com/peggyjv/sommelier/v4/x/cork/types"
+)
+
+// EndBlocker tallies cork votes once the current commit period has elapsed,
+// then prunes any scheduled corks that are now stale
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	params := k.GetParamSet(ctx)
+	periodStart, found := k.GetCommitPeriodStart(ctx)
+	if !found {
+		k.SetCommitPeriodStart(ctx, ctx.BlockHeight())
+		return
+	}
+
+	if ctx.BlockHeight() < periodStart+params.VotePeriod {
+		return
+	}
+
+	k.GetWinningVotes(ctx, params.VoteThreshold)
+
+	k.SetCommitPeriodStart(ctx, ctx.BlockHeight())
+
+	// Scheduled corks whose target height fell inside the commit period that
+	// just closed are now stale: the validator set missed its window to
+	// submit them, so there is no longer any point waiting on them.
+	k.PruneExpiredScheduledCorks(ctx, ctx.BlockHeight(), types.ScheduledCorkPruneReasonStale)
+}
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/peggyjv/sommelier/v4/x/cork/types"
 
 	"github.com/cosmos/cosmos-sdk/testutil"
@@ -62,3 +64,90 @@ func TestParseRemoveManagedCellarsProposal(t *testing.T) {
 	require.Equal(t, "0x456801a7D398351b8bE11C439e05C5B3259aeC9B", proposal.CellarIds[1])
 	require.Equal(t, "1000stake", proposal.Deposit)
 }
+
+// TestParseAddManagedCellarsGovV1Msg covers the gov/v1 replacement for
+// TestParseAddManagedCellarsProposal: a gov/v1 MsgSubmitProposal in JSON form,
+// parsed with the same
+// encodingConfig.Marshaler.UnmarshalJSON path as the legacy proposal test, so
+// the MsgAddManagedCellarIDs inside the "messages" Any is resolved through the
+// interface registry instead of being decoded by stdlib encoding/json.
+func TestParseAddManagedCellarsGovV1Msg(t *testing.T) {
+	encodingConfig := params.MakeTestEncodingConfig()
+	types.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+
+	okJSON := testutil.WriteToNewTempFile(t, `
+{
+  "messages": [
+    {
+      "@type": "/peggyjv.sommelier.cork.v1.MsgAddManagedCellarIDs",
+      "authority": "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+      "cellar_ids": ["0x123801a7D398351b8bE11C439e05C5B3259aeC9B", "0x456801a7D398351b8bE11C439e05C5B3259aeC9B"]
+    }
+  ],
+  "metadata": "Dollary-doos LP Cellar Proposal",
+  "proposer": "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+  "initial_deposit": [{"denom": "stake", "amount": "1000"}]
+}
+`)
+
+	var proposal govv1.MsgSubmitProposal
+	contents, err := ioutil.ReadFile(okJSON.Name())
+	require.NoError(t, err)
+
+	err = encodingConfig.Marshaler.UnmarshalJSON(contents, &proposal)
+	require.NoError(t, err)
+	require.Len(t, proposal.Messages, 1)
+
+	var sdkMsg sdk.Msg
+	require.NoError(t, encodingConfig.Marshaler.UnpackAny(proposal.Messages[0], &sdkMsg))
+
+	msg, ok := sdkMsg.(*types.MsgAddManagedCellarIDs)
+	require.True(t, ok)
+	require.Equal(t, "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn", msg.Authority)
+	require.Equal(t, "0x123801a7D398351b8bE11C439e05C5B3259aeC9B", msg.CellarIds[0])
+	require.Equal(t, "0x456801a7D398351b8bE11C439e05C5B3259aeC9B", msg.CellarIds[1])
+	require.Equal(t, "Dollary-doos LP Cellar Proposal", proposal.Metadata)
+	require.Equal(t, "1000", proposal.InitialDeposit[0].Amount.String())
+}
+
+// TestParseRemoveManagedCellarsGovV1Msg covers the gov/v1 replacement for
+// TestParseRemoveManagedCellarsProposal, the same way
+// TestParseAddManagedCellarsGovV1Msg covers MsgAddManagedCellarIDs.
+func TestParseRemoveManagedCellarsGovV1Msg(t *testing.T) {
+	encodingConfig := params.MakeTestEncodingConfig()
+	types.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+
+	okJSON := testutil.WriteToNewTempFile(t, `
+{
+  "messages": [
+    {
+      "@type": "/peggyjv.sommelier.cork.v1.MsgRemoveManagedCellarIDs",
+      "authority": "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+      "cellar_ids": ["0x123801a7D398351b8bE11C439e05C5B3259aeC9B", "0x456801a7D398351b8bE11C439e05C5B3259aeC9B"]
+    }
+  ],
+  "metadata": "Dollary-doos LP Cellar Proposal",
+  "proposer": "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn",
+  "initial_deposit": [{"denom": "stake", "amount": "1000"}]
+}
+`)
+
+	var proposal govv1.MsgSubmitProposal
+	contents, err := ioutil.ReadFile(okJSON.Name())
+	require.NoError(t, err)
+
+	err = encodingConfig.Marshaler.UnmarshalJSON(contents, &proposal)
+	require.NoError(t, err)
+	require.Len(t, proposal.Messages, 1)
+
+	var sdkMsg sdk.Msg
+	require.NoError(t, encodingConfig.Marshaler.UnpackAny(proposal.Messages[0], &sdkMsg))
+
+	msg, ok := sdkMsg.(*types.MsgRemoveManagedCellarIDs)
+	require.True(t, ok)
+	require.Equal(t, "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn", msg.Authority)
+	require.Equal(t, "0x123801a7D398351b8bE11C439e05C5B3259aeC9B", msg.CellarIds[0])
+	require.Equal(t, "0x456801a7D398351b8bE11C439e05C5B3259aeC9B", msg.CellarIds[1])
+	require.Equal(t, "Dollary-doos LP Cellar Proposal", proposal.Metadata)
+	require.Equal(t, "1000", proposal.InitialDeposit[0].Amount.String())
+}
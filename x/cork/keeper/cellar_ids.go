@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/sommelier/v4/x/cork/types"
+)
+
+// SetCellarIDs replaces the set of cellar addresses the cork module manages
+func (k Keeper) SetCellarIDs(ctx sdk.Context, ids types.CellarIDSet) {
+	_ = k.CellarIDs.Clear(ctx, nil)
+
+	for _, id := range ids.Ids {
+		if err := k.CellarIDs.Set(ctx, common.HexToAddress(id).Bytes()); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// GetCellarIDs returns the set of cellar addresses the cork module currently manages
+func (k Keeper) GetCellarIDs(ctx sdk.Context) []common.Address {
+	var ids []common.Address
+
+	_ = k.CellarIDs.Walk(ctx, nil, func(key []byte) (stop bool, err error) {
+		ids = append(ids, common.BytesToAddress(key))
+		return false, nil
+	})
+
+	return ids
+}
+
+// IsManagedCellarID returns true if cel is in the set of cellars the cork
+// module currently manages
+func (k Keeper) IsManagedCellarID(ctx sdk.Context, cel common.Address) bool {
+	has, err := k.CellarIDs.Has(ctx, cel.Bytes())
+	return err == nil && has
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/sommelier/v4/x/cork/types"
+)
+
+var _ types.GovMsgServer = Keeper{}
+
+// govAuthority is the address of the gov module account, the only signer
+// authorized to add or remove managed cellar IDs via MsgAddManagedCellarIDs /
+// MsgRemoveManagedCellarIDs
+func govAuthority() string {
+	return authtypes.NewModuleAddress(govtypes.ModuleName).String()
+}
+
+// AddManagedCellarIDs implements the Msg/AddManagedCellarIDs handler
+func (k Keeper) AddManagedCellarIDs(c context.Context, msg *types.MsgAddManagedCellarIDs) (*types.MsgAddManagedCellarIDsResponse, error) {
+	if msg.Authority != govAuthority() {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s, got %s", govAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	k.addManagedCellarIDs(ctx, msg.CellarIds)
+
+	return &types.MsgAddManagedCellarIDsResponse{}, nil
+}
+
+// RemoveManagedCellarIDs implements the Msg/RemoveManagedCellarIDs handler
+func (k Keeper) RemoveManagedCellarIDs(c context.Context, msg *types.MsgRemoveManagedCellarIDs) (*types.MsgRemoveManagedCellarIDsResponse, error) {
+	if msg.Authority != govAuthority() {
+		return nil, sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "expected %s, got %s", govAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	k.removeManagedCellarIDs(ctx, msg.CellarIds)
+
+	return &types.MsgRemoveManagedCellarIDsResponse{}, nil
+}
+
+// addManagedCellarIDs adds cellarIDs (hex-encoded addresses) to the set of
+// cellars the cork module manages. It is shared by the MsgAddManagedCellarIDs
+// handler and the legacy AddManagedCellarIDsProposal shim.
+func (k Keeper) addManagedCellarIDs(ctx sdk.Context, cellarIDs []string) error {
+	existing := make(map[string]bool)
+	var merged types.CellarIDSet
+	for _, id := range k.GetCellarIDs(ctx) {
+		existing[id.Hex()] = true
+		merged.Ids = append(merged.Ids, id.Hex())
+	}
+
+	for _, id := range cellarIDs {
+		if existing[id] {
+			continue
+		}
+
+		merged.Ids = append(merged.Ids, id)
+		existing[id] = true
+	}
+
+	k.SetCellarIDs(ctx, merged)
+
+	return nil
+}
+
+// removeManagedCellarIDs removes cellarIDs (hex-encoded addresses) from the set
+// of cellars the cork module manages, and prunes any pending scheduled corks
+// targeting a removed cellar since they will never be submitted. It is shared
+// by the MsgRemoveManagedCellarIDs handler and the legacy
+// RemoveManagedCellarIDsProposal shim.
+func (k Keeper) removeManagedCellarIDs(ctx sdk.Context, cellarIDs []string) {
+	removed := make(map[string]bool, len(cellarIDs))
+	for _, id := range cellarIDs {
+		removed[common.HexToAddress(id).Hex()] = true
+	}
+
+	var kept types.CellarIDSet
+	for _, id := range k.GetCellarIDs(ctx) {
+		if !removed[id.Hex()] {
+			kept.Ids = append(kept.Ids, id.Hex())
+		}
+	}
+
+	k.SetCellarIDs(ctx, kept)
+
+	for _, id := range cellarIDs {
+		k.pruneScheduledCorksForCellar(ctx, common.HexToAddress(id))
+	}
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/peggyjv/sommelier/v4/x/cork/types"
+)
+
+// RegisterInvariants registers all cork invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "managed-cellar-ids", ManagedCellarIDsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "invalidation-nonce-nondecreasing", InvalidationNonceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "scheduled-cork-height", ScheduledCorkHeightInvariant(k))
+}
+
+// AllInvariants runs all invariants of the cork module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if res, stop := ManagedCellarIDsInvariant(k)(ctx); stop {
+			return res, stop
+		}
+		if res, stop := InvalidationNonceInvariant(k)(ctx); stop {
+			return res, stop
+		}
+		return ScheduledCorkHeightInvariant(k)(ctx)
+	}
+}
+
+// ManagedCellarIDsInvariant checks that every stored cork's cellar address is in
+// the set of currently managed cellar IDs
+func ManagedCellarIDsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		managed := make(map[common.Address]bool)
+		for _, id := range k.GetCellarIDs(ctx) {
+			managed[id] = true
+		}
+
+		var unmanaged []string
+		k.IterateCorks(ctx, func(val sdk.ValAddress, cel common.Address, cork types.Cork) (stop bool) {
+			if !managed[cel] {
+				unmanaged = append(unmanaged, fmt.Sprintf("validator %s cork for unmanaged cellar %s", val.String(), cel.Hex()))
+			}
+			return false
+		})
+
+		broken := len(unmanaged) > 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "managed-cellar-ids",
+			fmt.Sprintf("found %d cork(s) for unmanaged cellars\n%s", len(unmanaged), formatInvariantLines(unmanaged)),
+		), broken
+	}
+}
+
+// InvalidationNonceInvariant checks that the latest invalidation nonce never decreases
+// across blocks. It compares the value currently in the store against the
+// high water mark recorded the last time this invariant ran. The mark is
+// itself stored in the keeper's InvalidationNonceHighWaterMark item, not kept
+// in memory, so the check still holds across process restarts and separate
+// invariant runs instead of resetting to 0 each time.
+func InvalidationNonceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		current := k.GetLatestInvalidationNonce(ctx)
+		highWaterMark := k.GetInvalidationNonceHighWaterMark(ctx)
+		broken := current < highWaterMark
+
+		if current > highWaterMark {
+			highWaterMark = current
+			k.setInvalidationNonceHighWaterMark(ctx, highWaterMark)
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "invalidation-nonce-nondecreasing",
+			fmt.Sprintf("latest invalidation nonce %d is less than previously observed high water mark %d", current, highWaterMark),
+		), broken
+	}
+}
+
+// ScheduledCorkHeightInvariant checks that every scheduled cork's target block height
+// is greater than or equal to the current commit period start
+func ScheduledCorkHeightInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		periodStart, _ := k.GetCommitPeriodStart(ctx)
+
+		var stale []string
+		for _, sc := range k.GetScheduledCorks(ctx) {
+			if sc.BlockHeight < periodStart {
+				stale = append(stale, fmt.Sprintf("validator %s scheduled cork at height %d is before commit period start %d", sc.Validator, sc.BlockHeight, periodStart))
+			}
+		}
+
+		broken := len(stale) > 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "scheduled-cork-height",
+			fmt.Sprintf("found %d scheduled cork(s) scheduled before the commit period start\n%s", len(stale), formatInvariantLines(stale)),
+		), broken
+	}
+}
+
+func formatInvariantLines(lines []string) string {
+	var out string
+	for _, l := range lines {
+		out += l + "\n"
+	}
+	return out
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvalidationNonceInvariant(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+
+	input.corkKeeper.SetLatestInvalidationNonce(ctx, 5)
+	_, broken := InvalidationNonceInvariant(input.corkKeeper)(ctx)
+	require.False(t, broken, "nonce rising to 5 should not break the invariant")
+
+	// The high water mark is persisted in the store, not held on the Keeper
+	// value, so it reflects what InvalidationNonceInvariant just observed
+	// regardless of which Keeper value runs the check next.
+	require.Equal(t, uint64(5), input.corkKeeper.GetInvalidationNonceHighWaterMark(ctx))
+
+	input.corkKeeper.SetLatestInvalidationNonce(ctx, 2)
+	_, broken = InvalidationNonceInvariant(input.corkKeeper)(ctx)
+	require.True(t, broken, "nonce dropping from the persisted high water mark of 5 to 2 should break the invariant")
+}
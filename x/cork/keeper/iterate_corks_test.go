@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"testing"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/peggyjv/sommelier/v4/x/cork/types"
+	"github.com/stretchr/testify/require"
+)
+
+// TestIterateAddressCorksIsPerValidator guards against IterateCorkAddresses and
+// IterateAddressCorks silently becoming the same function again: the former
+// reports every cellar address with a pending cork from any validator, while
+// the latter is scoped to a single validator.
+func TestIterateAddressCorksIsPerValidator(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+
+	valA, _ := sdktypes.ValAddressFromHex("24ep6yqkhpwnfdrrapu6fzmjp3xrpsgca11ab1e")
+	valB, _ := sdktypes.ValAddressFromHex("1wr4386xp9u0mtk8u56hdf5zuurga0hb01dface")
+
+	cellarA := common.HexToAddress("0xc0ffee254729296a45a3885639AC7E10F9d54979")
+	cellarB := common.HexToAddress("0xdeadbeef254729296a45a3885639AC7E10F9d549")
+
+	input.corkKeeper.SetCork(ctx, valA, types.Cork{Address: cellarA.Hex(), Body: []byte{1}})
+	input.corkKeeper.SetCork(ctx, valB, types.Cork{Address: cellarB.Hex(), Body: []byte{2}})
+
+	var allAddresses []common.Address
+	input.corkKeeper.IterateCorkAddresses(ctx, func(addr common.Address) (stop bool) {
+		allAddresses = append(allAddresses, addr)
+		return false
+	})
+	require.ElementsMatch(t, []common.Address{cellarA, cellarB}, allAddresses)
+
+	var valAAddresses []common.Address
+	input.corkKeeper.IterateAddressCorks(ctx, valA, func(addr common.Address) (stop bool) {
+		valAAddresses = append(valAAddresses, addr)
+		return false
+	})
+	require.Equal(t, []common.Address{cellarA}, valAAddresses)
+}
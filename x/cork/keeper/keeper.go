@@ -1,44 +1,111 @@
 package keeper
 
 import (
-	"bytes"
-
+	"cosmossdk.io/collections"
 	"github.com/cosmos/cosmos-sdk/codec"
-	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
-	mapset "github.com/deckarep/golang-set"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/peggyjv/sommelier/x/cork/types"
+	"github.com/peggyjv/sommelier/v4/x/cork/types"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
-// Keeper of the oracle store
+// Keeper of the cork store
 type Keeper struct {
-	storeKey      sdk.StoreKey
-	cdc           codec.BinaryCodec
-	paramSpace    paramtypes.Subspace
-	stakingKeeper types.StakingKeeper
-	gravityKeeper types.GravityKeeper
+	cdc            codec.BinaryCodec
+	paramSpace     paramtypes.Subspace
+	stakingAdapter types.StakingAdapter
+	gravityKeeper  types.GravityKeeper
+
+	Schema collections.Schema
+
+	// Corks is keyed by the validator address that submitted the cork, paired
+	// with the target cellar's address, in the same order as the legacy
+	// GetCorkForValidatorAddressKey layout. The encoded key bytes differ from
+	// that layout, since the pair codec length-prefixes the validator address
+	Corks collections.Map[collections.Pair[sdk.ValAddress, []byte], types.Cork]
+
+	// ScheduledCorks is keyed by the target block height, the validator
+	// address that submitted the cork, and the target cellar's address
+	ScheduledCorks collections.Map[collections.Triple[int64, sdk.ValAddress, []byte], types.Cork]
+
+	// ScheduledCorkQueue is the secondary index over ScheduledCorks used by
+	// IterateScheduledCorksInRange, keyed the same way as ScheduledCorks so a
+	// ranged walk over the height component is cheap
+	ScheduledCorkQueue collections.KeySet[collections.Triple[int64, sdk.ValAddress, []byte]]
+
+	// CellarIDs is the set of cellar addresses the cork module currently
+	// manages
+	CellarIDs collections.KeySet[[]byte]
+
+	LatestInvalidationNonce collections.Item[uint64]
+	CommitPeriodStart       collections.Item[int64]
+
+	// InvalidationNonceHighWaterMark is the highest latest invalidation nonce
+	// InvalidationNonceInvariant has ever observed. It's persisted rather than
+	// kept on the Keeper value so the check survives process restarts and
+	// separate invariant runs instead of silently resetting to 0
+	InvalidationNonceHighWaterMark collections.Item[uint64]
 }
 
-// NewKeeper creates a new distribution Keeper instance
+// NewKeeper creates a new cork Keeper instance
 func NewKeeper(
 	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
-	stakingKeeper types.StakingKeeper, gravityKeeper types.GravityKeeper,
+	stakingAdapter types.StakingAdapter, gravityKeeper types.GravityKeeper,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
-	return Keeper{
-		storeKey:      key,
-		cdc:           cdc,
-		paramSpace:    paramSpace,
-		stakingKeeper: stakingKeeper,
-		gravityKeeper: gravityKeeper,
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(key))
+
+	k := Keeper{
+		cdc:            cdc,
+		paramSpace:     paramSpace,
+		stakingAdapter: stakingAdapter,
+		gravityKeeper:  gravityKeeper,
+
+		Corks: collections.NewMap(
+			sb, collections.NewPrefix(types.CorkForAddressKeyPrefix), "corks",
+			collections.PairKeyCodec(sdk.ValAddressKey, collections.BytesKey),
+			codec.CollValue[types.Cork](cdc),
+		),
+		ScheduledCorks: collections.NewMap(
+			sb, collections.NewPrefix(types.ScheduledCorkKeyPrefix), "scheduled_corks",
+			collections.TripleKeyCodec(collections.Int64Key, sdk.ValAddressKey, collections.BytesKey),
+			codec.CollValue[types.Cork](cdc),
+		),
+		ScheduledCorkQueue: collections.NewKeySet(
+			sb, collections.NewPrefix(types.ScheduledCorkQueueKeyPrefix), "scheduled_cork_queue",
+			collections.TripleKeyCodec(collections.Int64Key, sdk.ValAddressKey, collections.BytesKey),
+		),
+		CellarIDs: collections.NewKeySet(
+			sb, collections.NewPrefix(types.CellarIDsKeyPrefix), "cellar_ids",
+			collections.BytesKey,
+		),
+		LatestInvalidationNonce: collections.NewItem(
+			sb, collections.NewPrefix(types.LatestInvalidationNonceKey), "latest_invalidation_nonce",
+			collections.Uint64Value,
+		),
+		CommitPeriodStart: collections.NewItem(
+			sb, collections.NewPrefix(types.CommitPeriodStartKey), "commit_period_start",
+			collections.Int64Value,
+		),
+		InvalidationNonceHighWaterMark: collections.NewItem(
+			sb, collections.NewPrefix(types.InvalidationNonceHighWaterMarkKey), "invalidation_nonce_high_water_mark",
+			collections.Uint64Value,
+		),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
 	}
+	k.Schema = schema
+
+	return k
 }
 
 // Logger returns a module-specific logger.
@@ -50,114 +117,91 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 // MsgSubmitCork //
 ///////////////////////
 
+// corkKey builds the (validator, cellar) pair key shared by every Corks accessor
+func corkKey(val sdk.ValAddress, cel common.Address) collections.Pair[sdk.ValAddress, []byte] {
+	return collections.Join(val, cel.Bytes())
+}
+
 // SetCork sets the prevote for a given validator
 // CONTRACT: must provide the validator address here not the delegate address
 func (k Keeper) SetCork(ctx sdk.Context, val sdk.ValAddress, cork types.Cork) {
-	bz := k.cdc.MustMarshal(&cork)
-	ctx.KVStore(k.storeKey).Set(types.GetCorkForValidatorAddressKey(val, common.HexToAddress(cork.Address)), bz)
+	if err := k.Corks.Set(ctx, corkKey(val, common.HexToAddress(cork.Address)), cork); err != nil {
+		panic(err)
+	}
 }
 
 // GetCork gets the prevote for a given validator
 // CONTRACT: must provide the validator address here not the delegate address
 func (k Keeper) GetCork(ctx sdk.Context, val sdk.ValAddress, cel common.Address) (types.Cork, bool) {
-	store := ctx.KVStore(k.storeKey)
-
-	bz := store.Get(types.GetCorkForValidatorAddressKey(val, cel))
-	if len(bz) == 0 {
+	cork, err := k.Corks.Get(ctx, corkKey(val, cel))
+	if err != nil {
 		return types.Cork{}, false
 	}
 
-	var cork types.Cork
-	k.cdc.MustUnmarshal(bz, &cork)
 	return cork, true
 }
 
 // DeleteCork deletes the prevote for a given validator
 // CONTRACT: must provide the validator address here not the delegate address
 func (k Keeper) DeleteCork(ctx sdk.Context, val sdk.ValAddress, cel common.Address) {
-	ctx.KVStore(k.storeKey).Delete(types.GetCorkForValidatorAddressKey(val, cel))
+	if err := k.Corks.Remove(ctx, corkKey(val, cel)); err != nil {
+		panic(err)
+	}
 }
 
 // HasCorkForContract gets the prevote for a given validator
 // CONTRACT: must provide the validator address here not the delegate address
 func (k Keeper) HasCorkForContract(ctx sdk.Context, val sdk.ValAddress, contract common.Address) bool {
-	return ctx.KVStore(k.storeKey).Has(types.GetCorkForValidatorAddressKey(val, contract))
+	has, err := k.Corks.Has(ctx, corkKey(val, contract))
+	if err != nil {
+		return false
+	}
+
+	return has
 }
 
 // HasCork gets the existence of any commit for a given validator
 // CONTRACT: must provide the validator address here not the delegate address
 func (k Keeper) HasCork(ctx sdk.Context, val sdk.ValAddress) bool {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetCorkValidatorKeyPrefix(val))
-	iter := store.Iterator(nil, nil)
-	defer iter.Close()
+	has := false
+
+	_ = k.Corks.Walk(ctx, collections.NewPrefixedPairRange[sdk.ValAddress, []byte](val), func(_ collections.Pair[sdk.ValAddress, []byte], _ types.Cork) (stop bool, err error) {
+		has = true
+		return true, nil
+	})
 
-	return iter.Valid()
+	return has
 }
 
 // IterateCorks iterates over all votes in the store
 func (k Keeper) IterateCorks(ctx sdk.Context, handler func(val sdk.ValAddress, cel common.Address, cork types.Cork) (stop bool)) {
-	store := ctx.KVStore(k.storeKey)
-	iter := sdk.KVStorePrefixIterator(store, []byte{types.CorkForAddressKeyPrefix})
-	defer iter.Close()
-	for ; iter.Valid(); iter.Next() {
-		keyPair := bytes.NewBuffer(bytes.TrimPrefix(iter.Key(), []byte{types.CorkForAddressKeyPrefix}))
-		val := sdk.ValAddress(keyPair.Next(20))
-		cel := common.BytesToAddress(keyPair.Bytes())
-
-		var cork types.Cork
-		k.cdc.MustUnmarshal(iter.Value(), &cork)
-		if handler(val, cel, cork) {
-			break
-		}
-	}
+	_ = k.Corks.Walk(ctx, nil, func(key collections.Pair[sdk.ValAddress, []byte], cork types.Cork) (stop bool, err error) {
+		return handler(key.K1(), common.BytesToAddress(key.K2()), cork), nil
+	})
 }
 
-// IterateCorkAddresses iterates over all addresses who have committed corks
+// IterateCorkAddresses iterates over every distinct cellar address that has a
+// cork pending from any validator
 func (k Keeper) IterateCorkAddresses(ctx sdk.Context, handler func(addr common.Address) (stop bool)) {
-	store := ctx.KVStore(k.storeKey)
-	iter := sdk.KVStorePrefixIterator(store, []byte{types.CorkForAddressKeyPrefix})
-	defer iter.Close()
-
-	seenAddresses := mapset.NewThreadUnsafeSet()
-
-	for ; iter.Valid(); iter.Next() {
-		keyPair := bytes.NewBuffer(bytes.TrimPrefix(iter.Key(), []byte{types.CorkForAddressKeyPrefix}))
-		keyPair.Next(20)
-		address := common.BytesToAddress(keyPair.Bytes())
+	seen := make(map[common.Address]bool)
 
-		// add seen address to set. if already in set, don't return to consumer
-		if !seenAddresses.Add(address) {
-			continue
+	_ = k.Corks.Walk(ctx, nil, func(key collections.Pair[sdk.ValAddress, []byte], _ types.Cork) (stop bool, err error) {
+		addr := common.BytesToAddress(key.K2())
+		if seen[addr] {
+			return false, nil
 		}
+		seen[addr] = true
 
-		if handler(address) {
-			break
-		}
-	}
+		return handler(addr), nil
+	})
 }
 
-// IterateAddressCorks iterates over all corks for an address
-func (k Keeper) IterateAddressCorks(ctx sdk.Context, handler func(addr common.Address) (stop bool)) {
-	store := ctx.KVStore(k.storeKey)
-	iter := sdk.KVStorePrefixIterator(store, []byte{types.CorkForAddressKeyPrefix})
-	defer iter.Close()
-
-	seenAddresses := mapset.NewThreadUnsafeSet()
-
-	for ; iter.Valid(); iter.Next() {
-		keyPair := bytes.NewBuffer(bytes.TrimPrefix(iter.Key(), []byte{types.CorkForAddressKeyPrefix}))
-		keyPair.Next(20)
-		address := common.BytesToAddress(keyPair.Bytes())
-
-		// add seen address to set. if already in set, don't return to consumer
-		if !seenAddresses.Add(address) {
-			continue
-		}
-
-		if handler(address) {
-			break
-		}
-	}
+// IterateAddressCorks iterates over every distinct cellar address that
+// validator val has a pending cork for
+func (k Keeper) IterateAddressCorks(ctx sdk.Context, val sdk.ValAddress, handler func(addr common.Address) (stop bool)) {
+	_ = k.Corks.Walk(ctx, collections.NewPrefixedPairRange[sdk.ValAddress, []byte](val), func(key collections.Pair[sdk.ValAddress, []byte], _ types.Cork) (stop bool, err error) {
+		return handler(common.BytesToAddress(key.K2())), nil
+	})
 }
 
 //////////////////
@@ -166,24 +210,25 @@ func (k Keeper) IterateAddressCorks(ctx sdk.Context, handler func(addr common.Ad
 
 // SetCommitPeriodStart sets the current vote period start height
 func (k Keeper) SetCommitPeriodStart(ctx sdk.Context, height int64) {
-	store := ctx.KVStore(k.storeKey)
-	store.Set([]byte{types.CommitPeriodStartKey}, sdk.Uint64ToBigEndian(uint64(height)))
+	if err := k.CommitPeriodStart.Set(ctx, height); err != nil {
+		panic(err)
+	}
 }
 
 // GetCommitPeriodStart returns the vote period start height
 func (k Keeper) GetCommitPeriodStart(ctx sdk.Context) (int64, bool) {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get([]byte{types.CommitPeriodStartKey})
-	if len(bz) == 0 {
+	height, err := k.CommitPeriodStart.Get(ctx)
+	if err != nil {
 		return 0, false
 	}
 
-	return int64(sdk.BigEndianToUint64(bz)), true
+	return height, true
 }
 
 // HasCommitPeriodStart returns true if the vote period start has been set
 func (k Keeper) HasCommitPeriodStart(ctx sdk.Context) bool {
-	return ctx.KVStore(k.storeKey).Has([]byte{types.CommitPeriodStartKey})
+	has, err := k.CommitPeriodStart.Has(ctx)
+	return err == nil && has
 }
 
 ////////////
@@ -207,23 +252,45 @@ func (k Keeper) setParams(ctx sdk.Context, params types.Params) {
 /////////////////////////
 
 func (k Keeper) GetLatestInvalidationNonce(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get([]byte{types.LatestInvalidationNonceKey})
-	return sdk.BigEndianToUint64(bz)
+	nonce, err := k.LatestInvalidationNonce.Get(ctx)
+	if err != nil {
+		return 0
+	}
+
+	return nonce
 }
 
 func (k Keeper) SetLatestInvalidationNonce(ctx sdk.Context, invalidationNonce uint64) {
-	store := ctx.KVStore(k.storeKey)
-	store.Set([]byte{types.LatestInvalidationNonceKey}, sdk.Uint64ToBigEndian(invalidationNonce))
+	if err := k.LatestInvalidationNonce.Set(ctx, invalidationNonce); err != nil {
+		panic(err)
+	}
 }
 
 func (k Keeper) IncrementInvalidationNonce(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
 	nextNonce := k.GetLatestInvalidationNonce(ctx) + 1
-	store.Set([]byte{types.LatestInvalidationNonceKey}, sdk.Uint64ToBigEndian(nextNonce))
+	k.SetLatestInvalidationNonce(ctx, nextNonce)
 	return nextNonce
 }
 
+// GetInvalidationNonceHighWaterMark returns the highest latest invalidation
+// nonce InvalidationNonceInvariant has ever observed
+func (k Keeper) GetInvalidationNonceHighWaterMark(ctx sdk.Context) uint64 {
+	mark, err := k.InvalidationNonceHighWaterMark.Get(ctx)
+	if err != nil {
+		return 0
+	}
+
+	return mark
+}
+
+// setInvalidationNonceHighWaterMark records mark as the highest latest
+// invalidation nonce InvalidationNonceInvariant has observed
+func (k Keeper) setInvalidationNonceHighWaterMark(ctx sdk.Context, mark uint64) {
+	if err := k.InvalidationNonceHighWaterMark.Set(ctx, mark); err != nil {
+		panic(err)
+	}
+}
+
 ///////////
 // Votes //
 ///////////
@@ -233,11 +300,10 @@ func (k Keeper) GetWinningVotes(ctx sdk.Context, threshold sdk.Dec) (winningVote
 	var corks []types.Cork
 	var corkPowers []int64
 
-	totalPower := k.stakingKeeper.GetLastTotalPower(ctx)
+	totalPower := k.stakingAdapter.TotalConsensusPower(ctx)
 
 	k.IterateCorks(ctx, func(val sdk.ValAddress, addr common.Address, cork types.Cork) (stop bool) {
-		validator := k.stakingKeeper.Validator(ctx, val)
-		validatorPower := validator.GetConsensusPower(k.stakingKeeper.PowerReduction(ctx))
+		validatorPower := k.stakingAdapter.ValidatorPower(ctx, val)
 
 		found := false
 		for i, rv := range corks {
@@ -262,11 +328,11 @@ func (k Keeper) GetWinningVotes(ctx sdk.Context, threshold sdk.Dec) (winningVote
 	var winningCorks []types.Cork
 
 	for i, power := range corkPowers {
-		quorumReached := sdk.NewDec(power).Quo(totalPower.ToDec()).GT(threshold)
+		quorumReached := sdk.NewDec(power).Quo(sdk.NewDec(totalPower)).GT(threshold)
 		if quorumReached {
 			winningCorks = append(winningCorks, corks[i])
 		}
 	}
 
 	return winningCorks
-}
\ No newline at end of file
+}
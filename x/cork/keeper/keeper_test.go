@@ -5,7 +5,7 @@ import (
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/peggyjv/sommelier/x/cork/types"
+	"github.com/peggyjv/sommelier/v4/x/cork/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -69,4 +69,33 @@ func TestGetWinningVotes(t *testing.T) {
 		winningVotes := input.corkKeeper.GetWinningVotes(ctx, sdktypes.MustNewDecFromStr("0.66"))
 		require.Lenf(t, winningVotes, 1, "require that winning votes contains only one cellar")
 	}
+}
+
+// TestGetWinningVotesWithInMemoryStakingAdapter exercises GetWinningVotes against
+// an InMemoryStakingAdapter instead of the default staking-keeper-backed
+// adapter, confirming that vote tallying only depends on the StakingAdapter
+// interface and not on any concrete staking keeper.
+func TestGetWinningVotesWithInMemoryStakingAdapter(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+
+	valA, _ := sdktypes.ValAddressFromHex("24ep6yqkhpwnfdrrapu6fzmjp3xrpsgca11ab1e")
+	valB, _ := sdktypes.ValAddressFromHex("1wr4386xp9u0mtk8u56hdf5zuurga0hb01dface")
+
+	adapter := types.NewInMemoryStakingAdapter()
+	adapter.SetValidatorPower(valA, 60)
+	adapter.SetValidatorPower(valB, 40)
+	input.corkKeeper.stakingAdapter = adapter
+
+	commit := types.Cork{
+		Address: exampleAddrA.String(),
+		Body:    []byte{33},
+	}
+
+	input.corkKeeper.SetCork(ctx, valA, commit)
+	input.corkKeeper.SetCork(ctx, valB, commit)
+
+	winningVotes := input.corkKeeper.GetWinningVotes(ctx, sdktypes.MustNewDecFromStr("0.66"))
+	require.Lenf(t, winningVotes, 1, "require that the combined power of both validators crosses the threshold")
+	require.Equal(t, commit, winningVotes[0])
 }
\ No newline at end of file
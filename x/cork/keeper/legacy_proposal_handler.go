@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/peggyjv/sommelier/v4/x/cork/types"
+)
+
+// NewCorkProposalHandler routes legacy gov/v1beta1 AddManagedCellarIDsProposal
+// and RemoveManagedCellarIDsProposal content through the same mutation logic
+// as the gov/v1 MsgAddManagedCellarIDs / MsgRemoveManagedCellarIDs handlers, so
+// that proposals already in flight when this release activates still execute.
+func NewCorkProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.AddManagedCellarIDsProposal:
+			return k.addManagedCellarIDs(ctx, c.CellarIds)
+		case *types.RemoveManagedCellarIDsProposal:
+			k.removeManagedCellarIDs(ctx, c.CellarIds)
+			return nil
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized cork proposal content type: %T", c)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/sommelier/v4/x/cork/types"
+)
+
+// SetScheduledCork schedules cork to be submitted by val once the chain
+// reaches blockHeight. If val already has a cork scheduled for the same
+// cellar at a different height, the stale entry is pruned with reason
+// ScheduledCorkPruneReasonSuperseded before the new one is stored.
+//
+// The supersede check below is a full walk of ScheduledCorks, not a bounded
+// lookup: ScheduledCorks and ScheduledCorkQueue are both keyed height-first,
+// since that's what IterateScheduledCorksInRange needs, so neither store can
+// answer "does (val, cellar) already have an entry" without scanning every
+// scheduled cork. A validator can only have a handful of corks scheduled at
+// once, and SetScheduledCork is only called from InitGenesis, not per-block,
+// so the scan is acceptable here; it would need a
+// dedicated (val, cellar) -> height index if either assumption stops holding.
+func (k Keeper) SetScheduledCork(ctx sdk.Context, blockHeight int64, val sdk.ValAddress, cork types.Cork) {
+	cellar := common.HexToAddress(cork.Address)
+
+	var supersededHeights []int64
+	_ = k.ScheduledCorks.Walk(ctx, nil, func(key collections.Triple[int64, sdk.ValAddress, []byte], _ types.Cork) (stop bool, err error) {
+		if key.K1() == blockHeight || !bytes.Equal(key.K2(), val) || !bytes.Equal(key.K3(), cellar.Bytes()) {
+			return false, nil
+		}
+
+		supersededHeights = append(supersededHeights, key.K1())
+		return false, nil
+	})
+
+	for _, height := range supersededHeights {
+		k.deleteScheduledCorkAndIndex(ctx, height, val, cellar, types.ScheduledCorkPruneReasonSuperseded)
+	}
+
+	key := scheduledCorkKey(blockHeight, val, cellar)
+	if err := k.ScheduledCorks.Set(ctx, key, cork); err != nil {
+		panic(err)
+	}
+
+	k.IndexScheduledCork(ctx, blockHeight, val, cellar)
+}
+
+// DeleteScheduledCork removes the cork validator val has scheduled at
+// blockHeight, along with its ScheduledCorkQueue index entry
+func (k Keeper) DeleteScheduledCork(ctx sdk.Context, blockHeight int64, val sdk.ValAddress) {
+	_ = k.ScheduledCorks.Walk(ctx, nil, func(key collections.Triple[int64, sdk.ValAddress, []byte], _ types.Cork) (stop bool, err error) {
+		if key.K1() != blockHeight || !bytes.Equal(key.K2(), val) {
+			return false, nil
+		}
+
+		if err := k.ScheduledCorks.Remove(ctx, key); err != nil {
+			return true, err
+		}
+
+		k.DeindexScheduledCork(ctx, key.K1(), key.K2(), common.BytesToAddress(key.K3()))
+
+		return true, nil
+	})
+}
+
+// GetScheduledCorks returns every scheduled cork in the store, in the form
+// used by GenesisState, with the validator address hex-encoded as InitGenesis
+// reads it
+func (k Keeper) GetScheduledCorks(ctx sdk.Context) []types.ValidatorScheduledCork {
+	var out []types.ValidatorScheduledCork
+
+	_ = k.ScheduledCorks.Walk(ctx, nil, func(key collections.Triple[int64, sdk.ValAddress, []byte], cork types.Cork) (stop bool, err error) {
+		c := cork
+		out = append(out, types.ValidatorScheduledCork{
+			Validator:   hex.EncodeToString(key.K2()),
+			BlockHeight: key.K1(),
+			Cork:        &c,
+		})
+
+		return false, nil
+	})
+
+	return out
+}
+
+// GetValidatorCorks returns every pending cork in the store, in the form used
+// by GenesisState, with the validator address hex-encoded as InitGenesis reads
+// it
+func (k Keeper) GetValidatorCorks(ctx sdk.Context) []types.ValidatorCork {
+	var out []types.ValidatorCork
+
+	k.IterateCorks(ctx, func(val sdk.ValAddress, _ common.Address, cork types.Cork) (stop bool) {
+		c := cork
+		out = append(out, types.ValidatorCork{
+			Validator: hex.EncodeToString(val),
+			Cork:      &c,
+		})
+
+		return false
+	})
+
+	return out
+}
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"fmt"
+	"math"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/peggyjv/sommelier/v4/x/cork/types"
+)
+
+// scheduledCorkKey builds the (height, validator, cellar) triple key shared by
+// ScheduledCorks and ScheduledCorkQueue
+func scheduledCorkKey(blockHeight int64, val sdk.ValAddress, cel common.Address) collections.Triple[int64, sdk.ValAddress, []byte] {
+	return collections.Join3(blockHeight, val, cel.Bytes())
+}
+
+// IndexScheduledCork adds a scheduled cork to the secondary scheduled-cork
+// queue index, so that it can be found by IterateScheduledCorksInRange without
+// scanning every scheduled cork in the store. SetScheduledCork calls this
+// itself; it stays exported alongside DeindexScheduledCork for symmetry with
+// the rest of this file's keeper API.
+func (k Keeper) IndexScheduledCork(ctx sdk.Context, blockHeight int64, val sdk.ValAddress, cel common.Address) {
+	if err := k.ScheduledCorkQueue.Set(ctx, scheduledCorkKey(blockHeight, val, cel)); err != nil {
+		panic(err)
+	}
+}
+
+// DeindexScheduledCork removes a scheduled cork from the secondary
+// scheduled-cork queue index
+func (k Keeper) DeindexScheduledCork(ctx sdk.Context, blockHeight int64, val sdk.ValAddress, cel common.Address) {
+	if err := k.ScheduledCorkQueue.Remove(ctx, scheduledCorkKey(blockHeight, val, cel)); err != nil {
+		panic(err)
+	}
+}
+
+// IterateScheduledCorksInRange walks ScheduledCorkQueue in height order and
+// invokes cb for every entry whose target height falls in
+// [startHeight, endHeight], mirroring the ActiveProposalQueue iterator
+// pattern used in x/gov. The queue is keyed height-first so entries are
+// already walked in ascending height order.
+func (k Keeper) IterateScheduledCorksInRange(
+	ctx sdk.Context, startHeight, endHeight int64,
+	cb func(blockHeight int64, val sdk.ValAddress, cel common.Address) (stop bool),
+) {
+	rng := new(collections.Range[collections.Triple[int64, sdk.ValAddress, []byte]]).
+		StartInclusive(collections.Join3[int64, sdk.ValAddress, []byte](startHeight, sdk.ValAddress{}, []byte{}))
+
+	// endHeight+1 would overflow for the math.MaxInt64 sentinel used by
+	// pruneScheduledCorksForCellar, so leave the range open-ended on that side
+	if endHeight < math.MaxInt64 {
+		rng = rng.EndExclusive(collections.Join3[int64, sdk.ValAddress, []byte](endHeight+1, sdk.ValAddress{}, []byte{}))
+	}
+
+	_ = k.ScheduledCorkQueue.Walk(ctx, rng, func(key collections.Triple[int64, sdk.ValAddress, []byte]) (stop bool, err error) {
+		return cb(key.K1(), key.K2(), common.BytesToAddress(key.K3())), nil
+	})
+}
+
+// PruneExpiredScheduledCorks removes every scheduled cork targeting a block
+// height strictly less than olderThan, deleting both the primary entry and
+// the secondary queue index, and emitting a typed event per pruned entry so
+// relayers can observe why a scheduled call never landed.
+func (k Keeper) PruneExpiredScheduledCorks(ctx sdk.Context, olderThan int64, reason types.ScheduledCorkPruneReason) {
+	type pruneKey struct {
+		height int64
+		val    sdk.ValAddress
+		cel    common.Address
+	}
+
+	var toPrune []pruneKey
+	k.IterateScheduledCorksInRange(ctx, 0, olderThan-1, func(blockHeight int64, val sdk.ValAddress, cel common.Address) (stop bool) {
+		toPrune = append(toPrune, pruneKey{blockHeight, val, cel})
+		return false
+	})
+
+	for _, p := range toPrune {
+		k.deleteScheduledCorkAndIndex(ctx, p.height, p.val, p.cel, reason)
+	}
+}
+
+// pruneScheduledCorksForCellar prunes every pending scheduled cork targeting
+// cellarID, regardless of its target height. It is called when cellarID is
+// removed from the managed set, since a scheduled call to a cellar the module
+// no longer manages will never be submitted.
+func (k Keeper) pruneScheduledCorksForCellar(ctx sdk.Context, cellarID common.Address) {
+	type pruneKey struct {
+		height int64
+		val    sdk.ValAddress
+	}
+
+	var toPrune []pruneKey
+	k.IterateScheduledCorksInRange(ctx, 0, math.MaxInt64, func(blockHeight int64, val sdk.ValAddress, cel common.Address) (stop bool) {
+		if cel == cellarID {
+			toPrune = append(toPrune, pruneKey{blockHeight, val})
+		}
+		return false
+	})
+
+	for _, p := range toPrune {
+		k.deleteScheduledCorkAndIndex(ctx, p.height, p.val, cellarID, types.ScheduledCorkPruneReasonCellarRemoved)
+	}
+}
+
+func (k Keeper) deleteScheduledCorkAndIndex(ctx sdk.Context, height int64, val sdk.ValAddress, cel common.Address, reason types.ScheduledCorkPruneReason) {
+	if err := k.ScheduledCorks.Remove(ctx, scheduledCorkKey(height, val, cel)); err != nil {
+		panic(err)
+	}
+	k.DeindexScheduledCork(ctx, height, val, cel)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePruneScheduledCork,
+			sdk.NewAttribute(types.AttributeKeyValidator, val.String()),
+			sdk.NewAttribute(types.AttributeKeyCellarAddress, cel.Hex()),
+			sdk.NewAttribute(types.AttributeKeyBlockHeight, fmt.Sprintf("%d", height)),
+			sdk.NewAttribute(types.AttributeKeyPruneReason, reason.String()),
+		),
+	)
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"testing"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/peggyjv/sommelier/v4/x/cork/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIterateScheduledCorksInRange(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+
+	val, _ := sdktypes.ValAddressFromHex("24ep6yqkhpwnfdrrapu6fzmjp3xrpsgca11ab1e")
+	celA := common.HexToAddress("0xc0ffee254729296a45a3885639AC7E10F9d54979")
+	celB := common.HexToAddress("0xdeadbeef254729296a45a3885639AC7E10F9d549")
+
+	input.corkKeeper.SetScheduledCork(ctx, 10, val, types.Cork{Address: celA.Hex(), Body: []byte{1}})
+	input.corkKeeper.SetScheduledCork(ctx, 20, val, types.Cork{Address: celB.Hex(), Body: []byte{2}})
+
+	var heights []int64
+	input.corkKeeper.IterateScheduledCorksInRange(ctx, 0, 15, func(blockHeight int64, v sdktypes.ValAddress, c common.Address) (stop bool) {
+		heights = append(heights, blockHeight)
+		return false
+	})
+
+	require.Equal(t, []int64{10}, heights)
+}
+
+func TestPruneExpiredScheduledCorks(t *testing.T) {
+	input := CreateTestEnv(t)
+	ctx := input.Context
+
+	val, _ := sdktypes.ValAddressFromHex("24ep6yqkhpwnfdrrapu6fzmjp3xrpsgca11ab1e")
+	cel := common.HexToAddress("0xc0ffee254729296a45a3885639AC7E10F9d54979")
+
+	input.corkKeeper.SetScheduledCork(ctx, 10, val, types.Cork{Address: cel.Hex(), Body: []byte{1}})
+
+	input.corkKeeper.PruneExpiredScheduledCorks(ctx, 20, types.ScheduledCorkPruneReasonStale)
+
+	var remaining []int64
+	input.corkKeeper.IterateScheduledCorksInRange(ctx, 0, 100, func(blockHeight int64, v sdktypes.ValAddress, c common.Address) (stop bool) {
+		remaining = append(remaining, blockHeight)
+		return false
+	})
+
+	require.Empty(t, remaining, "pruned scheduled cork should no longer be indexed")
+}
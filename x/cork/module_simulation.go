@@ -0,0 +1,44 @@
+package cork
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/peggyjv/sommelier/v4/x/cork/simulation"
+	"github.com/peggyjv/sommelier/v4/x/cork/types"
+)
+
+// GenerateGenesisState creates a randomized GenState of the cork module
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+// ProposalContents returns content functions for governance proposals
+func (am AppModule) ProposalContents(simState module.SimulationState) []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		simulation.SimulateAddManagedCellarIDsProposal(am.keeper),
+		simulation.SimulateRemoveManagedCellarIDsProposal(am.keeper),
+	}
+}
+
+// RandomizedParams returns no param changes: the simulator does not randomize
+// cork params
+func (AppModule) RandomizedParams(r *rand.Rand) []simtypes.ParamChange {
+	return nil
+}
+
+// RegisterStoreDecoder registers a decoder for cork module's types
+func (am AppModule) RegisterStoreDecoder(sdr sdk.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simulation.NewDecodeStore(am.cdc)
+}
+
+// WeightedOperations returns the all the cork module operations with their respective weights
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(
+		simState.AppParams, simState.Cdc, simState.TxConfig,
+		am.accountKeeper, am.stakingKeeper, am.keeper,
+	)
+}
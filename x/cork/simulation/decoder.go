@@ -0,0 +1,49 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/peggyjv/sommelier/v4/x/cork/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding cork type.
+func NewDecodeStore(cdc codec.BinaryCodec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], []byte{types.CorkForAddressKeyPrefix}):
+			var corkA, corkB types.Cork
+			cdc.MustUnmarshal(kvA.Value, &corkA)
+			cdc.MustUnmarshal(kvB.Value, &corkB)
+			return fmt.Sprintf("%v\n%v", corkA, corkB)
+
+		case bytes.Equal(kvA.Key[:1], []byte{types.ScheduledCorkKeyPrefix}):
+			var corkA, corkB types.Cork
+			cdc.MustUnmarshal(kvA.Value, &corkA)
+			cdc.MustUnmarshal(kvB.Value, &corkB)
+			return fmt.Sprintf("%v\n%v", corkA, corkB)
+
+		case bytes.Equal(kvA.Key[:1], []byte{types.ScheduledCorkQueueKeyPrefix}):
+			return fmt.Sprintf("%x\n%x", kvA.Key, kvB.Key)
+
+		case bytes.Equal(kvA.Key[:1], []byte{types.CellarIDsKeyPrefix}):
+			return fmt.Sprintf("%x\n%x", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], []byte{types.CommitPeriodStartKey}):
+			return fmt.Sprintf("%v\n%v", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], []byte{types.LatestInvalidationNonceKey}):
+			return fmt.Sprintf("%v\n%v", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], []byte{types.InvalidationNonceHighWaterMarkKey}):
+			return fmt.Sprintf("%v\n%v", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		default:
+			panic(fmt.Sprintf("invalid cork key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
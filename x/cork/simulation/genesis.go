@@ -0,0 +1,34 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/peggyjv/sommelier/v4/x/cork/types"
+)
+
+// RandomizedGenState generates a random GenesisState for cork
+func RandomizedGenState(simState *module.SimulationState) {
+	var cellarIDs types.CellarIDSet
+	numCellars := simState.Rand.Intn(5) + 1
+	for i := 0; i < numCellars; i++ {
+		cellarIDs.Ids = append(cellarIDs.Ids, randomCellarID(simState.Rand).Hex())
+	}
+
+	genesis := types.GenesisState{
+		Params:            types.DefaultParams(),
+		CellarIds:         cellarIDs,
+		InvalidationNonce: uint64(simState.Rand.Intn(100)),
+	}
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&genesis)
+}
+
+// randomCellarID returns a random, syntactically valid, ethereum address to use
+// as a managed cellar ID in the simulated genesis state.
+func randomCellarID(r *rand.Rand) common.Address {
+	var bz [common.AddressLength]byte
+	r.Read(bz[:])
+	return common.BytesToAddress(bz[:])
+}
@@ -0,0 +1,136 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/peggyjv/sommelier/v4/x/cork/keeper"
+	"github.com/peggyjv/sommelier/v4/x/cork/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgSubmitCork = "op_weight_msg_submit_cork"
+
+	DefaultWeightMsgSubmitCork = 100
+)
+
+// WeightedOperations returns all the operations from the cork module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, txGen client.TxConfig,
+	ak types.AccountKeeper, sk types.StakingKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgSubmitCork int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSubmitCork, &weightMsgSubmitCork, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubmitCork = DefaultWeightMsgSubmitCork
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgSubmitCork, SimulateMsgSubmitCork(txGen, ak, sk, k)),
+	}
+}
+
+// SimulateMsgSubmitCork generates a MsgSubmitCorkRequest signed by the simulated
+// account at a random bonded validator's operator address, targeting one of
+// the currently managed cellar IDs.
+func SimulateMsgSubmitCork(txGen client.TxConfig, ak types.AccountKeeper, sk types.StakingKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		cellarIDs := k.GetCellarIDs(ctx)
+		if len(cellarIDs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.EventTypeSubmitCork, "no managed cellar ids"), nil, nil
+		}
+
+		validators := sk.GetBondedValidatorsByPower(ctx)
+		if len(validators) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.EventTypeSubmitCork, "no bonded validators"), nil, nil
+		}
+
+		validator := validators[r.Intn(len(validators))]
+		valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator().String())
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.EventTypeSubmitCork, "invalid validator address"), nil, err
+		}
+
+		simAccount, found := simtypes.FindAccount(accs, sdk.AccAddress(valAddr))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.EventTypeSubmitCork, "validator account not found"), nil, nil
+		}
+
+		cellarID := cellarIDs[r.Intn(len(cellarIDs))]
+		body := make([]byte, 4+r.Intn(64))
+		r.Read(body)
+
+		msg := types.NewMsgSubmitCorkRequest(types.Cork{
+			Address: cellarID.Hex(),
+			Body:    body,
+		}, valAddr)
+
+		spendable := sdk.NewCoins()
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: spendable,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateAddManagedCellarIDsProposal generates a random AddManagedCellarIDsProposal content.
+func SimulateAddManagedCellarIDsProposal(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		var newIDs []string
+		for i := 0; i < r.Intn(3)+1; i++ {
+			newIDs = append(newIDs, randomCellarID(r).Hex())
+		}
+
+		return &types.AddManagedCellarIDsProposal{
+			Title:       simtypes.RandStringOfLength(r, 10),
+			Description: simtypes.RandStringOfLength(r, 100),
+			CellarIds:   newIDs,
+		}
+	}
+}
+
+// SimulateRemoveManagedCellarIDsProposal generates a random RemoveManagedCellarIDsProposal
+// content, targeting cellar IDs that the module is currently managing.
+func SimulateRemoveManagedCellarIDsProposal(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		cellarIDs := k.GetCellarIDs(ctx)
+		if len(cellarIDs) == 0 {
+			return nil
+		}
+
+		removed := []common.Address{cellarIDs[r.Intn(len(cellarIDs))]}
+		var ids []string
+		for _, id := range removed {
+			ids = append(ids, id.Hex())
+		}
+
+		return &types.RemoveManagedCellarIDsProposal{
+			Title:       simtypes.RandStringOfLength(r, 10),
+			Description: simtypes.RandStringOfLength(r, 100),
+			CellarIds:   ids,
+		}
+	}
+}
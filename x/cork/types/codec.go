@@ -12,12 +12,20 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgSubmitCorkRequest{},
+		&MsgAddManagedCellarIDs{},
+		&MsgRemoveManagedCellarIDs{},
 	)
 
+	// AddManagedCellarIDsProposal and RemoveManagedCellarIDsProposal are kept
+	// registered as legacy gov/v1beta1 Content for one release so that
+	// proposals already in flight on-chain still execute. New proposals
+	// should use MsgAddManagedCellarIDs / MsgRemoveManagedCellarIDs via
+	// gov/v1 instead.
 	registry.RegisterImplementations((*govtypes.Content)(nil),
 		&AddManagedCellarIDsProposal{},
 		&RemoveManagedCellarIDsProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+	msgservice.RegisterMsgServiceDesc(registry, &_GovMsg_serviceDesc)
 }
@@ -0,0 +1,6 @@
+package types
+
+// InvalidationNonceHighWaterMarkKey stores the persisted high water mark used
+// by keeper.InvalidationNonceInvariant to detect the latest invalidation
+// nonce going backwards across blocks
+const InvalidationNonceHighWaterMarkKey = 0x08
@@ -0,0 +1,80 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgAddManagedCellarIDs    = "add_managed_cellar_ids"
+	TypeMsgRemoveManagedCellarIDs = "remove_managed_cellar_ids"
+)
+
+var (
+	_ sdk.Msg = &MsgAddManagedCellarIDs{}
+	_ sdk.Msg = &MsgRemoveManagedCellarIDs{}
+)
+
+func NewMsgAddManagedCellarIDs(authority string, cellarIDs []string) *MsgAddManagedCellarIDs {
+	return &MsgAddManagedCellarIDs{Authority: authority, CellarIds: cellarIDs}
+}
+
+func (m *MsgAddManagedCellarIDs) Route() string { return ModuleName }
+func (m *MsgAddManagedCellarIDs) Type() string  { return TypeMsgAddManagedCellarIDs }
+
+func (m *MsgAddManagedCellarIDs) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgAddManagedCellarIDs) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m *MsgAddManagedCellarIDs) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	if len(m.CellarIds) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cellar ids cannot be empty")
+	}
+
+	return nil
+}
+
+func NewMsgRemoveManagedCellarIDs(authority string, cellarIDs []string) *MsgRemoveManagedCellarIDs {
+	return &MsgRemoveManagedCellarIDs{Authority: authority, CellarIds: cellarIDs}
+}
+
+func (m *MsgRemoveManagedCellarIDs) Route() string { return ModuleName }
+func (m *MsgRemoveManagedCellarIDs) Type() string  { return TypeMsgRemoveManagedCellarIDs }
+
+func (m *MsgRemoveManagedCellarIDs) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{addr}
+}
+
+func (m *MsgRemoveManagedCellarIDs) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m *MsgRemoveManagedCellarIDs) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrap(err, "invalid authority address")
+	}
+
+	if len(m.CellarIds) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cellar ids cannot be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package types
+
+// ScheduledCorkQueueKeyPrefix prefixes the secondary scheduled-cork queue
+// index (keeper.Keeper.ScheduledCorkQueue), keyed the same way as
+// ScheduledCorks so due or stale corks can be found with a ranged iterator
+// instead of a scan over every scheduled cork in the store. It mirrors the
+// ActiveProposalQueue iterator pattern used in x/gov.
+const ScheduledCorkQueueKeyPrefix = 0x07
+
+// Event types, attribute keys and attribute values emitted when a scheduled
+// cork is pruned instead of landing on its target block
+const (
+	EventTypePruneScheduledCork = "prune_scheduled_cork"
+
+	AttributeKeyValidator     = "validator"
+	AttributeKeyCellarAddress = "cellar_address"
+	AttributeKeyBlockHeight   = "block_height"
+	AttributeKeyPruneReason   = "reason"
+)
+
+// ScheduledCorkPruneReason explains why a scheduled cork was pruned instead of
+// landing on its target block
+type ScheduledCorkPruneReason int32
+
+const (
+	// ScheduledCorkPruneReasonStale indicates the scheduled cork's target
+	// block height has passed without the cork landing
+	ScheduledCorkPruneReasonStale ScheduledCorkPruneReason = iota
+	// ScheduledCorkPruneReasonSuperseded indicates a newer scheduled cork for
+	// the same validator and cellar replaced this one before it landed
+	ScheduledCorkPruneReasonSuperseded
+	// ScheduledCorkPruneReasonCellarRemoved indicates the target cellar was
+	// removed from the managed set before the cork landed
+	ScheduledCorkPruneReasonCellarRemoved
+)
+
+// String implements fmt.Stringer
+func (r ScheduledCorkPruneReason) String() string {
+	switch r {
+	case ScheduledCorkPruneReasonStale:
+		return "stale"
+	case ScheduledCorkPruneReasonSuperseded:
+		return "superseded"
+	case ScheduledCorkPruneReasonCellarRemoved:
+		return "cellar_removed"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StakingAdapter is a narrow view over however a deployment manages its
+// validator set. The cork keeper tallies corks against whatever this interface
+// reports, so a deployment can satisfy it with a Proof-of-Engagement-style
+// valset (for example a CosmWasm-backed valset contract) and plug it into the
+// cork module without forking it.
+type StakingAdapter interface {
+	// TotalConsensusPower returns the total consensus power backing the
+	// validator set that cork votes are tallied against.
+	TotalConsensusPower(ctx sdk.Context) int64
+
+	// ValidatorPower returns the consensus power of a single validator. It
+	// returns 0 if the validator is not known to the adapter.
+	ValidatorPower(ctx sdk.Context, valAddr sdk.ValAddress) int64
+
+	// IterateBondedValidators calls cb for every bonded validator known to the
+	// adapter, stopping early if cb returns true.
+	IterateBondedValidators(ctx sdk.Context, cb func(valAddr sdk.ValAddress) (stop bool))
+
+	// IsJailed reports whether a validator is jailed and should be excluded
+	// from cork vote tallying.
+	IsJailed(ctx sdk.Context, valAddr sdk.ValAddress) bool
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// XStakingAdapter adapts the SDK x/staking keeper to the StakingAdapter
+// interface. Nothing in this module wires it up: NewKeeper uses whichever
+// StakingAdapter its caller passes.
+type XStakingAdapter struct {
+	stakingKeeper stakingkeeper.Keeper
+}
+
+// NewXStakingAdapter returns a StakingAdapter backed by the SDK x/staking keeper
+func NewXStakingAdapter(stakingKeeper stakingkeeper.Keeper) XStakingAdapter {
+	return XStakingAdapter{stakingKeeper: stakingKeeper}
+}
+
+func (a XStakingAdapter) TotalConsensusPower(ctx sdk.Context) int64 {
+	return a.stakingKeeper.GetLastTotalPower(ctx).Int64()
+}
+
+func (a XStakingAdapter) ValidatorPower(ctx sdk.Context, valAddr sdk.ValAddress) int64 {
+	validator, found := a.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return 0
+	}
+
+	return validator.GetConsensusPower(a.stakingKeeper.PowerReduction(ctx))
+}
+
+func (a XStakingAdapter) IterateBondedValidators(ctx sdk.Context, cb func(valAddr sdk.ValAddress) (stop bool)) {
+	a.stakingKeeper.IterateBondedValidatorsByPower(ctx, func(_ int64, validator stakingtypes.ValidatorI) bool {
+		valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator().String())
+		if err != nil {
+			return false
+		}
+
+		return cb(valAddr)
+	})
+}
+
+func (a XStakingAdapter) IsJailed(ctx sdk.Context, valAddr sdk.ValAddress) bool {
+	validator, found := a.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return true
+	}
+
+	return validator.IsJailed()
+}
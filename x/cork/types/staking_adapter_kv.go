@@ -0,0 +1,90 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// KVStakingAdapter is a StakingAdapter for chains that manage their validator
+// set outside of x/staking (for example a Proof-of-Engagement valset derived
+// from delegation elsewhere). Power is keyed by the validator's Bech32
+// address directly in its own KV store, so the cork module never needs to
+// know how that power was derived.
+var (
+	// KVStakingAdapterPowerKeyPrefix prefixes a Bech32-encoded validator
+	// address to its stored voting power
+	KVStakingAdapterPowerKeyPrefix = []byte{0x01}
+
+	// KVStakingAdapterJailedKeyPrefix prefixes a Bech32-encoded validator
+	// address to its stored jailed flag
+	KVStakingAdapterJailedKeyPrefix = []byte{0x02}
+)
+
+// KVStakingAdapter implements StakingAdapter against a dedicated KV store,
+// keyed by Bech32 validator address rather than the raw x/staking layout.
+type KVStakingAdapter struct {
+	storeKey sdk.StoreKey
+}
+
+// NewKVStakingAdapter returns a StakingAdapter backed by the given store
+func NewKVStakingAdapter(storeKey sdk.StoreKey) KVStakingAdapter {
+	return KVStakingAdapter{storeKey: storeKey}
+}
+
+// SetValidatorPower sets the consensus power recorded for valAddr
+func (a KVStakingAdapter) SetValidatorPower(ctx sdk.Context, valAddr sdk.ValAddress, power int64) {
+	store := ctx.KVStore(a.storeKey)
+	store.Set(append(KVStakingAdapterPowerKeyPrefix, []byte(valAddr.String())...), sdk.Uint64ToBigEndian(uint64(power)))
+}
+
+// SetJailed marks valAddr as jailed or unjailed
+func (a KVStakingAdapter) SetJailed(ctx sdk.Context, valAddr sdk.ValAddress, jailed bool) {
+	store := ctx.KVStore(a.storeKey)
+	key := append(KVStakingAdapterJailedKeyPrefix, []byte(valAddr.String())...)
+	if jailed {
+		store.Set(key, []byte{1})
+	} else {
+		store.Delete(key)
+	}
+}
+
+func (a KVStakingAdapter) TotalConsensusPower(ctx sdk.Context) int64 {
+	var total int64
+	a.IterateBondedValidators(ctx, func(valAddr sdk.ValAddress) (stop bool) {
+		total += a.ValidatorPower(ctx, valAddr)
+		return false
+	})
+
+	return total
+}
+
+func (a KVStakingAdapter) ValidatorPower(ctx sdk.Context, valAddr sdk.ValAddress) int64 {
+	store := ctx.KVStore(a.storeKey)
+	bz := store.Get(append(KVStakingAdapterPowerKeyPrefix, []byte(valAddr.String())...))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return int64(sdk.BigEndianToUint64(bz))
+}
+
+func (a KVStakingAdapter) IterateBondedValidators(ctx sdk.Context, cb func(valAddr sdk.ValAddress) (stop bool)) {
+	store := ctx.KVStore(a.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, KVStakingAdapterPowerKeyPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		valAddr, err := sdk.ValAddressFromBech32(string(iter.Key()[len(KVStakingAdapterPowerKeyPrefix):]))
+		if err != nil {
+			continue
+		}
+
+		if cb(valAddr) {
+			break
+		}
+	}
+}
+
+func (a KVStakingAdapter) IsJailed(ctx sdk.Context, valAddr sdk.ValAddress) bool {
+	store := ctx.KVStore(a.storeKey)
+	return store.Has(append(KVStakingAdapterJailedKeyPrefix, []byte(valAddr.String())...))
+}